@@ -36,7 +36,7 @@ var categoriesListCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(categories)
+		outputList(categories, categoryColumns)
 	},
 }
 
@@ -65,7 +65,7 @@ var categoriesCreateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(category)
+		outputRecord(category, categoryColumns)
 	},
 }
 
@@ -98,7 +98,7 @@ var categoriesUpdateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(category)
+		outputRecord(category, categoryColumns)
 	},
 }
 
@@ -114,7 +114,7 @@ var categoriesDeleteCmd = &cobra.Command{
 			outputError("Failed to delete category", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Category deleted successfully",
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/hausdog/cli/internal/config"
@@ -21,9 +22,12 @@ API URL and API key settings for different environments.
 Examples:
   hausdog config set local --api-url http://localhost:3333/api/v1 --api-key hd_xxx
   hausdog config set prod --api-url https://hausdog.app/api/v1 --api-key hd_yyy
+  hausdog config set prod --default-property <property-id>
   hausdog config use local
   hausdog config list
-  hausdog config show local`,
+  hausdog config show local
+  hausdog config export --redact
+  hausdog config import --file config.yaml --merge`,
 }
 
 var configSetCmd = &cobra.Command{
@@ -35,6 +39,7 @@ var configSetCmd = &cobra.Command{
 
 		url, _ := cmd.Flags().GetString("api-url")
 		key, _ := cmd.Flags().GetString("api-key")
+		propertyID, _ := cmd.Flags().GetString("default-property")
 
 		// Load existing config
 		cfg, err := config.Load()
@@ -56,6 +61,9 @@ var configSetCmd = &cobra.Command{
 		if key != "" {
 			profile.APIKey = key
 		}
+		if cmd.Flags().Changed("default-property") {
+			profile.DefaultProperty = propertyID
+		}
 
 		// Validate
 		if profile.APIURL == "" {
@@ -73,14 +81,18 @@ var configSetCmd = &cobra.Command{
 
 		if outputFmt == "json" {
 			outputJSON(map[string]interface{}{
-				"status":  "created",
-				"profile": name,
-				"api_url": profile.APIURL,
+				"status":           "created",
+				"profile":          name,
+				"api_url":          profile.APIURL,
+				"default_property": profile.DefaultProperty,
 			})
 		} else {
-			fmt.Printf("Profile %q saved\n", name)
-			fmt.Printf("  API URL: %s\n", profile.APIURL)
-			fmt.Printf("  API Key: %s...%s\n", profile.APIKey[:6], profile.APIKey[len(profile.APIKey)-4:])
+			fmt.Fprintf(out, "Profile %q saved\n", name)
+			fmt.Fprintf(out, "  API URL: %s\n", profile.APIURL)
+			fmt.Fprintf(out, "  API Key: %s\n", maskAPIKey(profile.APIKey))
+			if profile.DefaultProperty != "" {
+				fmt.Fprintf(out, "  Default Property: %s\n", profile.DefaultProperty)
+			}
 		}
 	},
 }
@@ -102,29 +114,33 @@ var configListCmd = &cobra.Command{
 			profiles := []map[string]string{}
 			for name, p := range cfg.Profiles {
 				profiles = append(profiles, map[string]string{
-					"name":    name,
-					"api_url": p.APIURL,
+					"name":             name,
+					"api_url":          p.APIURL,
+					"default_property": p.DefaultProperty,
 				})
 			}
 			result["profiles"] = profiles
 			outputJSON(result)
 		} else {
 			if len(cfg.Profiles) == 0 {
-				fmt.Println("No profiles configured")
-				fmt.Println("\nCreate one with:")
-				fmt.Println("  hausdog config set local --api-url http://localhost:3333/api/v1 --api-key <key>")
+				fmt.Fprintln(out, "No profiles configured")
+				fmt.Fprintln(out, "\nCreate one with:")
+				fmt.Fprintln(out, "  hausdog config set local --api-url http://localhost:3333/api/v1 --api-key <key>")
 				return
 			}
 
-			fmt.Printf("Default: %s\n\n", cfg.Default)
-			fmt.Println("Profiles:")
+			fmt.Fprintf(out, "Default: %s\n\n", cfg.Default)
+			fmt.Fprintln(out, "Profiles:")
 			for name, p := range cfg.Profiles {
 				marker := "  "
 				if name == cfg.Default {
 					marker = "* "
 				}
-				fmt.Printf("%s%s\n", marker, name)
-				fmt.Printf("    API URL: %s\n", p.APIURL)
+				fmt.Fprintf(out, "%s%s\n", marker, name)
+				fmt.Fprintf(out, "    API URL: %s\n", p.APIURL)
+				if p.DefaultProperty != "" {
+					fmt.Fprintf(out, "    Default Property: %s\n", p.DefaultProperty)
+				}
 			}
 		}
 	},
@@ -156,14 +172,18 @@ var configShowCmd = &cobra.Command{
 
 		if outputFmt == "json" {
 			outputJSON(map[string]interface{}{
-				"name":    name,
-				"api_url": profile.APIURL,
-				"api_key": profile.APIKey[:6] + "..." + profile.APIKey[len(profile.APIKey)-4:],
+				"name":             name,
+				"api_url":          profile.APIURL,
+				"api_key":          maskAPIKey(profile.APIKey),
+				"default_property": profile.DefaultProperty,
 			})
 		} else {
-			fmt.Printf("Profile: %s\n", name)
-			fmt.Printf("  API URL: %s\n", profile.APIURL)
-			fmt.Printf("  API Key: %s...%s\n", profile.APIKey[:6], profile.APIKey[len(profile.APIKey)-4:])
+			fmt.Fprintf(out, "Profile: %s\n", name)
+			fmt.Fprintf(out, "  API URL: %s\n", profile.APIURL)
+			fmt.Fprintf(out, "  API Key: %s\n", maskAPIKey(profile.APIKey))
+			if profile.DefaultProperty != "" {
+				fmt.Fprintf(out, "  Default Property: %s\n", profile.DefaultProperty)
+			}
 		}
 	},
 }
@@ -196,7 +216,7 @@ var configUseCmd = &cobra.Command{
 				"default": name,
 			})
 		} else {
-			fmt.Printf("Default profile set to %q\n", name)
+			fmt.Fprintf(out, "Default profile set to %q\n", name)
 		}
 	},
 }
@@ -239,7 +259,7 @@ var configDeleteCmd = &cobra.Command{
 				"profile": name,
 			})
 		} else {
-			fmt.Printf("Profile %q deleted\n", name)
+			fmt.Fprintf(out, "Profile %q deleted\n", name)
 		}
 	},
 }
@@ -252,12 +272,120 @@ var configPathCmd = &cobra.Command{
 		if outputFmt == "json" {
 			outputJSON(map[string]string{"path": path})
 		} else {
-			fmt.Println(path)
+			fmt.Fprintln(out, path)
+		}
+	},
+}
+
+var configExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the config file as YAML",
+	Long: `Print all profiles as YAML, for copying to another machine.
+
+Use --redact to mask API keys when sharing the output. Redacted exports
+cannot be imported.
+
+Examples:
+  hausdog config export > hausdog.yaml
+  hausdog config export --redact`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			outputError("Failed to load config", err)
+		}
+
+		if exportRedact {
+			for name, p := range cfg.Profiles {
+				p.APIKey = maskAPIKey(p.APIKey)
+				cfg.Profiles[name] = p
+			}
+			cfg.Redacted = true
+		}
+
+		data, err := config.Marshal(cfg)
+		if err != nil {
+			outputError("Failed to export config", err)
+		}
+
+		if _, err := out.Write(data); err != nil {
+			outputError("Failed to write config", err)
 		}
 	},
 }
 
-var forceDelete bool
+var configImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import profiles from a YAML file",
+	Long: `Import profiles from a file written by 'config export'.
+
+By default the imported file replaces all existing profiles. With --merge,
+imported profiles are added to the existing ones, overwriting profiles with
+the same name, and the current default is kept.
+
+Examples:
+  hausdog config import --file hausdog.yaml
+  hausdog config import --file hausdog.yaml --merge`,
+	Run: func(cmd *cobra.Command, args []string) {
+		data, err := os.ReadFile(importFile)
+		if err != nil {
+			outputError("Failed to read file", err)
+		}
+
+		imported, err := config.Parse(data)
+		if err != nil {
+			outputError("Failed to import config", err)
+		}
+
+		if err := imported.Validate(); err != nil {
+			outputError("Invalid config", err)
+		}
+
+		cfg := imported
+		mode := "replace"
+		if importMerge {
+			mode = "merge"
+			cfg, err = config.Load()
+			if err != nil {
+				outputError("Failed to load config", err)
+			}
+			cfg.Merge(imported)
+		}
+
+		if err := config.Save(cfg); err != nil {
+			outputError("Failed to save config", err)
+		}
+
+		names := imported.ListProfiles()
+		sort.Strings(names)
+
+		if outputFmt == "json" {
+			outputJSON(map[string]interface{}{
+				"status":   "imported",
+				"mode":     mode,
+				"profiles": names,
+				"default":  cfg.Default,
+			})
+		} else {
+			fmt.Fprintf(out, "Imported %d profile(s) (%s)\n", len(imported.Profiles), mode)
+			fmt.Fprintf(out, "Default: %s\n", cfg.Default)
+		}
+	},
+}
+
+// maskAPIKey hides all but the prefix and last 4 characters of a key
+func maskAPIKey(key string) string {
+	if len(key) <= 10 {
+		return "..."
+	}
+	return key[:6] + "..." + key[len(key)-4:]
+}
+
+var (
+	forceDelete  bool
+	exportRedact bool
+	importFile   string
+	importMerge  bool
+)
 
 func init() {
 	rootCmd.AddCommand(configCmd)
@@ -267,11 +395,22 @@ func init() {
 	configCmd.AddCommand(configUseCmd)
 	configCmd.AddCommand(configDeleteCmd)
 	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configExportCmd)
+	configCmd.AddCommand(configImportCmd)
 
 	// Set flags
 	configSetCmd.Flags().String("api-url", "", "API base URL")
 	configSetCmd.Flags().String("api-key", "", "API key")
+	configSetCmd.Flags().String("default-property", "", "Property used when --property is omitted")
 
 	// Delete flags
 	configDeleteCmd.Flags().BoolVarP(&forceDelete, "force", "f", false, "Skip confirmation")
+
+	// Export flags
+	configExportCmd.Flags().BoolVar(&exportRedact, "redact", false, "Mask API keys in the output")
+
+	// Import flags
+	configImportCmd.Flags().StringVar(&importFile, "file", "", "Path to config YAML (required)")
+	configImportCmd.Flags().BoolVar(&importMerge, "merge", false, "Merge with existing profiles instead of replacing them")
+	configImportCmd.MarkFlagRequired("file")
 }
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hausdog/cli/internal/config"
+)
+
+// setupTestConfig points the config file at a temp dir seeded with cfg
+// and captures command output
+func setupTestConfig(t *testing.T, cfg *config.Config) *bytes.Buffer {
+	t.Helper()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	if cfg != nil {
+		if err := config.Save(cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	prevOut, prevFmt := out, outputFmt
+	t.Cleanup(func() { out, outputFmt = prevOut, prevFmt })
+
+	var buf bytes.Buffer
+	out = &buf
+	outputFmt = "json"
+	return &buf
+}
+
+func existingConfig() *config.Config {
+	return &config.Config{
+		Default: "local",
+		Profiles: map[string]config.Profile{
+			"local": {APIURL: "http://localhost:3333/api/v1", APIKey: "hd_local_1234567890"},
+			"prod":  {APIURL: "https://hausdog.app/api/v1", APIKey: "hd_prod_old_123456"},
+		},
+	}
+}
+
+func writeImportFile(t *testing.T, cfg *config.Config) string {
+	t.Helper()
+	var data []byte
+	if cfg != nil {
+		var err error
+		if data, err = config.Marshal(cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "import.yaml")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigImport(t *testing.T) {
+	// Rejected imports exit through outputError, so they run in a subprocess
+	if path := os.Getenv("HAUSDOG_TEST_IMPORT_FILE"); path != "" {
+		importFile = path
+		configImportCmd.Run(configImportCmd, nil)
+		return
+	}
+
+	newProd := &config.Config{
+		Default: "prod",
+		Profiles: map[string]config.Profile{
+			"prod": {APIURL: "https://hausdog.app/api/v1", APIKey: "hd_prod_new_123456"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		imported     *config.Config
+		merge        bool
+		wantExit     bool
+		wantProfiles []string
+		wantDefault  string
+		wantProdKey  string
+	}{
+		{"replace", newProd, false, false, []string{"prod"}, "prod", "hd_prod_new_123456"},
+		{"merge", newProd, true, false, []string{"local", "prod"}, "local", "hd_prod_new_123456"},
+		{"empty file", nil, false, true, []string{"local", "prod"}, "local", "hd_prod_old_123456"},
+		{"no profiles", &config.Config{}, false, true, []string{"local", "prod"}, "local", "hd_prod_old_123456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTestConfig(t, existingConfig())
+			path := writeImportFile(t, tt.imported)
+
+			if tt.wantExit {
+				cmd := exec.Command(os.Args[0], "-test.run=^TestConfigImport$")
+				cmd.Env = append(os.Environ(), "HAUSDOG_TEST_IMPORT_FILE="+path)
+				output, err := cmd.CombinedOutput()
+				var exitErr *exec.ExitError
+				if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitError {
+					t.Fatalf("err = %v, want exit code %d\n%s", err, exitError, output)
+				}
+			} else {
+				prevFile, prevMerge := importFile, importMerge
+				t.Cleanup(func() { importFile, importMerge = prevFile, prevMerge })
+				importFile, importMerge = path, tt.merge
+
+				configImportCmd.Run(configImportCmd, nil)
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(cfg.Profiles) != len(tt.wantProfiles) {
+				t.Errorf("profiles = %v, want %v", cfg.ListProfiles(), tt.wantProfiles)
+			}
+			for _, name := range tt.wantProfiles {
+				if _, ok := cfg.Profiles[name]; !ok {
+					t.Errorf("profile %q missing", name)
+				}
+			}
+			if cfg.Profiles["prod"].APIKey != tt.wantProdKey {
+				t.Errorf("prod key = %q, want %q", cfg.Profiles["prod"].APIKey, tt.wantProdKey)
+			}
+			if cfg.Default != tt.wantDefault {
+				t.Errorf("Default = %q, want %q", cfg.Default, tt.wantDefault)
+			}
+		})
+	}
+}
+
+func TestConfigExportRedact(t *testing.T) {
+	buf := setupTestConfig(t, existingConfig())
+
+	prev := exportRedact
+	t.Cleanup(func() { exportRedact = prev })
+	exportRedact = true
+
+	configExportCmd.Run(configExportCmd, nil)
+
+	if strings.Contains(buf.String(), "hd_local_1234567890") {
+		t.Errorf("redacted export contains a full key:\n%s", buf.String())
+	}
+
+	exported, err := config.Parse(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exported.Redacted {
+		t.Error("redacted export is not marked redacted")
+	}
+	if exported.Validate() == nil {
+		t.Error("redacted export passes Validate(), so it could be imported")
+	}
+}
+
+func TestConfigShowShortKey(t *testing.T) {
+	buf := setupTestConfig(t, &config.Config{
+		Default: "local",
+		Profiles: map[string]config.Profile{
+			"local": {APIURL: "http://localhost:3333/api/v1", APIKey: "abc"},
+		},
+	})
+	outputFmt = "table"
+
+	configShowCmd.Run(configShowCmd, nil)
+
+	if strings.Contains(buf.String(), "abc") {
+		t.Errorf("short key shown unmasked:\n%s", buf.String())
+	}
+}
+
+func TestConfigListShowsDefaultProperty(t *testing.T) {
+	seed := existingConfig()
+	prod := seed.Profiles["prod"]
+	prod.DefaultProperty = "p1"
+	seed.Profiles["prod"] = prod
+	buf := setupTestConfig(t, seed)
+
+	configListCmd.Run(configListCmd, nil)
+
+	var result struct {
+		Profiles []map[string]string `json:"profiles"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range result.Profiles {
+		if p["name"] == "prod" && p["default_property"] != "p1" {
+			t.Errorf("prod default_property = %q, want p1", p["default_property"])
+		}
+	}
+
+	buf.Reset()
+	outputFmt = "table"
+	configListCmd.Run(configListCmd, nil)
+	if !strings.Contains(buf.String(), "Default Property: p1") {
+		t.Errorf("text output missing default property:\n%s", buf.String())
+	}
+}
@@ -22,6 +22,7 @@ var (
 	docFilePath   string
 	docStdin      bool
 	docURL        string
+	docNoExtract  bool
 )
 
 var documentsCmd = &cobra.Command{
@@ -34,9 +35,7 @@ var documentsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List documents for a property",
 	Run: func(cmd *cobra.Command, args []string) {
-		if docPropertyID == "" {
-			outputError("Property ID required", fmt.Errorf("use --property flag"))
-		}
+		docPropertyID = requirePropertyID(docPropertyID)
 
 		c := client.NewSimple(getAPIURL(), requireAPIKey())
 
@@ -55,7 +54,7 @@ var documentsListCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(documents)
+		outputList(documents, documentColumns)
 	},
 }
 
@@ -76,7 +75,7 @@ var documentsGetCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(document)
+		outputRecord(document, documentColumns)
 	},
 }
 
@@ -92,12 +91,16 @@ Examples:
   # Upload from file (primary method)
   hausdog documents upload --property <id> --file /path/to/photo.jpg
 
+  # Upload to the default property (or your only one, created if you have none)
+  hausdog documents upload --file /path/to/photo.jpg
+
   # Upload and associate with an item
-  hausdog documents upload --property <id> --file /path/to/receipt.pdf --item <item-id>`,
+  hausdog documents upload --property <id> --file /path/to/receipt.pdf --item <item-id>
+
+  # Store a reference photo without running extraction
+  hausdog documents upload --property <id> --file /path/to/swatch.jpg --no-extract`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if docPropertyID == "" {
-			outputError("Property ID required", fmt.Errorf("use --property flag"))
-		}
+		docPropertyID = requireOrCreatePropertyID(docPropertyID)
 
 		if docFilePath == "" {
 			outputError("File path required", fmt.Errorf("use --file flag"))
@@ -129,6 +132,12 @@ Examples:
 			outputError("Failed to copy file", err)
 		}
 
+		if docNoExtract {
+			if err := writer.WriteField("extract", "false"); err != nil {
+				outputError("Failed to create form field", err)
+			}
+		}
+
 		writer.Close()
 
 		// Build URL
@@ -160,11 +169,7 @@ Examples:
 		}
 
 		if resp.StatusCode >= 400 {
-			var apiErr client.APIError
-			if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
-				outputError("Upload failed", fmt.Errorf("%s", apiErr.Message))
-			}
-			outputError("Upload failed", fmt.Errorf("status %d: %s", resp.StatusCode, string(body)))
+			outputError("Upload failed", client.NewStatusError(resp.StatusCode, body))
 		}
 
 		var result map[string]interface{}
@@ -176,7 +181,35 @@ Examples:
 		result["uploadedFile"] = filepath.Base(docFilePath)
 		result["fileSize"] = fileInfo.Size()
 
-		outputJSON(result)
+		outputRecord(result, []string{"id", "fileName", "status", "message"})
+	},
+}
+
+var documentsReprocessCmd = &cobra.Command{
+	Use:   "reprocess <id>",
+	Short: "Reprocess a document",
+	Long: `Queue a document for extraction again.
+
+The document is reset to pending and the new status is returned. Poll with
+'documents get <id>' to follow progress.
+
+Examples:
+  hausdog documents reprocess <id>`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c := client.NewSimple(getAPIURL(), requireAPIKey())
+
+		data, err := c.Post("/documents/"+args[0]+"/reprocess", nil)
+		if err != nil {
+			outputError("Failed to reprocess document", err)
+		}
+
+		var result map[string]interface{}
+		if err := json.Unmarshal(data, &result); err != nil {
+			outputError("Failed to parse response", err)
+		}
+
+		outputRecord(result, []string{"id", "status", "message"})
 	},
 }
 
@@ -192,7 +225,7 @@ var documentsDeleteCmd = &cobra.Command{
 			outputError("Failed to delete document", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Document deleted successfully",
@@ -205,17 +238,17 @@ func init() {
 	documentsCmd.AddCommand(documentsListCmd)
 	documentsCmd.AddCommand(documentsGetCmd)
 	documentsCmd.AddCommand(documentsUploadCmd)
+	documentsCmd.AddCommand(documentsReprocessCmd)
 	documentsCmd.AddCommand(documentsDeleteCmd)
 
 	// List flags
-	documentsListCmd.Flags().StringVar(&docPropertyID, "property", "", "Property ID (required)")
+	documentsListCmd.Flags().StringVar(&docPropertyID, "property", "", "Property ID (defaults to the configured default property)")
 	documentsListCmd.Flags().StringVar(&docStatus, "status", "", "Filter by status: pending, processing, ready_for_review, confirmed")
-	documentsListCmd.MarkFlagRequired("property")
 
 	// Upload flags
-	documentsUploadCmd.Flags().StringVar(&docPropertyID, "property", "", "Property ID (required)")
+	documentsUploadCmd.Flags().StringVar(&docPropertyID, "property", "", "Property ID (defaults to the configured default property)")
 	documentsUploadCmd.Flags().StringVar(&docFilePath, "file", "", "Path to file to upload (required)")
 	documentsUploadCmd.Flags().StringVar(&docItemID, "item", "", "Associate with item ID")
-	documentsUploadCmd.MarkFlagRequired("property")
+	documentsUploadCmd.Flags().BoolVar(&docNoExtract, "no-extract", false, "Store the document without running extraction")
 	documentsUploadCmd.MarkFlagRequired("file")
 }
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDocumentsReprocess(t *testing.T) {
+	var gotMethod, gotPath, gotAuth string
+	buf := setupTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath, gotAuth = r.Method, r.URL.RequestURI(), r.Header.Get("Authorization")
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":      "d1",
+			"status":  "pending",
+			"message": "Document queued for processing",
+		})
+	}))
+
+	documentsReprocessCmd.Run(documentsReprocessCmd, []string{"d1"})
+
+	if gotMethod != http.MethodPost || gotPath != "/documents/d1/reprocess" {
+		t.Errorf("request = %s %s, want POST /documents/d1/reprocess", gotMethod, gotPath)
+	}
+	if gotAuth != "Bearer hd_testkey1234" {
+		t.Errorf("Authorization = %q", gotAuth)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, buf.String())
+	}
+	if result["status"] != "pending" {
+		t.Errorf("status = %v, want pending", result["status"])
+	}
+}
+
+func TestDocumentsUploadExtractField(t *testing.T) {
+	tests := []struct {
+		name      string
+		noExtract bool
+		want      string
+	}{
+		{"default", false, ""},
+		{"no extract", true, "false"},
+	}
+
+	path := filepath.Join(t.TempDir(), "receipt.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-1.4"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotExtract, gotFile string
+			setupTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					t.Errorf("ParseMultipartForm: %v", err)
+				}
+				gotExtract = r.FormValue("extract")
+				if _, header, err := r.FormFile("file"); err == nil {
+					gotFile = header.Filename
+				}
+				writeJSON(w, http.StatusCreated, map[string]interface{}{"id": "d1", "status": "pending"})
+			}))
+
+			prevProp, prevFile, prevNoExtract := docPropertyID, docFilePath, docNoExtract
+			t.Cleanup(func() { docPropertyID, docFilePath, docNoExtract = prevProp, prevFile, prevNoExtract })
+			docPropertyID, docFilePath, docNoExtract = "p1", path, tt.noExtract
+
+			documentsUploadCmd.Run(documentsUploadCmd, nil)
+
+			if gotPath != "/properties/p1/documents/upload" {
+				t.Errorf("path = %q", gotPath)
+			}
+			if gotFile != "receipt.pdf" {
+				t.Errorf("file = %q, want receipt.pdf", gotFile)
+			}
+			if gotExtract != tt.want {
+				t.Errorf("extract = %q, want %q", gotExtract, tt.want)
+			}
+		})
+	}
+}
@@ -43,7 +43,7 @@ var eventsListCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(events)
+		outputList(events, eventColumns)
 	},
 }
 
@@ -64,7 +64,7 @@ var eventsGetCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(event)
+		outputRecord(event, eventColumns)
 	},
 }
 
@@ -110,7 +110,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(event)
+		outputRecord(event, eventColumns)
 	},
 }
 
@@ -152,7 +152,7 @@ var eventsUpdateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(event)
+		outputRecord(event, eventColumns)
 	},
 }
 
@@ -168,7 +168,7 @@ var eventsDeleteCmd = &cobra.Command{
 			outputError("Failed to delete event", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Event deleted successfully",
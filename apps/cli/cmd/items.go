@@ -32,9 +32,7 @@ var itemsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List items for a property",
 	Run: func(cmd *cobra.Command, args []string) {
-		if itemPropertyID == "" {
-			outputError("Property ID required", fmt.Errorf("use --property flag"))
-		}
+		itemPropertyID = requirePropertyID(itemPropertyID)
 
 		c := client.NewSimple(getAPIURL(), requireAPIKey())
 
@@ -53,7 +51,7 @@ var itemsListCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(items)
+		outputList(items, itemColumns)
 	},
 }
 
@@ -74,7 +72,7 @@ var itemsGetCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(item)
+		outputRecord(item, itemColumns)
 	},
 }
 
@@ -95,7 +93,7 @@ var itemsChildrenCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(items)
+		outputList(items, itemColumns)
 	},
 }
 
@@ -103,9 +101,7 @@ var itemsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new item",
 	Run: func(cmd *cobra.Command, args []string) {
-		if itemPropertyID == "" {
-			outputError("Property ID required", fmt.Errorf("use --property flag"))
-		}
+		itemPropertyID = requireOrCreatePropertyID(itemPropertyID)
 
 		c := client.NewSimple(getAPIURL(), requireAPIKey())
 
@@ -149,7 +145,7 @@ var itemsCreateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(item)
+		outputRecord(item, itemColumns)
 	},
 }
 
@@ -206,7 +202,7 @@ var itemsUpdateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(item)
+		outputRecord(item, itemColumns)
 	},
 }
 
@@ -222,7 +218,7 @@ var itemsDeleteCmd = &cobra.Command{
 			outputError("Failed to delete item", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Item deleted successfully",
@@ -240,12 +236,11 @@ func init() {
 	itemsCmd.AddCommand(itemsDeleteCmd)
 
 	// List flags
-	itemsListCmd.Flags().StringVar(&itemPropertyID, "property", "", "Property ID (required)")
+	itemsListCmd.Flags().StringVar(&itemPropertyID, "property", "", "Property ID (defaults to the configured default property)")
 	itemsListCmd.Flags().StringVar(&itemSpaceID, "space", "", "Filter by space ID")
-	itemsListCmd.MarkFlagRequired("property")
 
 	// Create flags
-	itemsCreateCmd.Flags().StringVar(&itemPropertyID, "property", "", "Property ID (required)")
+	itemsCreateCmd.Flags().StringVar(&itemPropertyID, "property", "", "Property ID (defaults to the configured default property)")
 	itemsCreateCmd.Flags().StringVar(&itemName, "name", "", "Item name (required)")
 	itemsCreateCmd.Flags().StringVar(&itemCategory, "category", "", "Category: appliance, automotive, hvac, plumbing, electrical, etc (required)")
 	itemsCreateCmd.Flags().StringVar(&itemSpaceID, "space", "", "Space ID")
@@ -256,7 +251,6 @@ func init() {
 	itemsCreateCmd.Flags().StringVar(&itemAcquiredDate, "acquired-date", "", "Acquired/installed date (YYYY-MM-DD)")
 	itemsCreateCmd.Flags().StringVar(&itemDescription, "description", "", "Item description")
 	itemsCreateCmd.Flags().StringVar(&itemNotes, "notes", "", "Notes")
-	itemsCreateCmd.MarkFlagRequired("property")
 	itemsCreateCmd.MarkFlagRequired("name")
 	itemsCreateCmd.MarkFlagRequired("category")
 
@@ -53,7 +53,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(tasks)
+		outputList(tasks, maintenanceColumns)
 	},
 }
 
@@ -84,7 +84,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(tasks)
+		outputList(tasks, maintenanceColumns)
 	},
 }
 
@@ -105,7 +105,7 @@ var maintenanceGetCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(task)
+		outputRecord(task, maintenanceColumns)
 	},
 }
 
@@ -144,7 +144,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(task)
+		outputRecord(task, maintenanceColumns)
 	},
 }
 
@@ -192,7 +192,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(task)
+		outputRecord(task, maintenanceColumns)
 	},
 }
 
@@ -234,7 +234,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(task)
+		outputRecord(task, maintenanceColumns)
 	},
 }
 
@@ -259,7 +259,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(task)
+		outputRecord(task, maintenanceColumns)
 	},
 }
 
@@ -288,7 +288,7 @@ Examples:
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(result)
+		outputRecord(result, []string{"success", "method", "count"})
 	},
 }
 
@@ -304,7 +304,7 @@ var maintenanceDeleteCmd = &cobra.Command{
 			outputError("Failed to delete maintenance task", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Maintenance task deleted successfully",
@@ -3,6 +3,7 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"github.com/hausdog/cli/internal/client"
 	"github.com/spf13/cobra"
@@ -30,7 +31,7 @@ var propertiesListCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(properties)
+		outputList(properties, propertyColumns)
 	},
 }
 
@@ -51,10 +52,128 @@ var propertiesGetCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(property)
+		outputRecord(property, propertyColumns)
 	},
 }
 
+// treeConcurrency bounds the number of parallel child fetches in properties tree
+const treeConcurrency = 4
+
+var propertiesTreeCmd = &cobra.Command{
+	Use:   "tree <id>",
+	Short: "Show a property's full item hierarchy",
+	Long: `Show a property with all of its items and their child items.
+
+In json mode the result is a nested object where each item has a "children"
+array. In table mode it prints an indented tree.
+
+Examples:
+  hausdog properties tree <id>
+  hausdog properties tree <id> --format table`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c := client.NewSimple(getAPIURL(), requireAPIKey())
+
+		data, err := c.Get("/properties/" + args[0])
+		if err != nil {
+			outputError("Failed to get property", err)
+		}
+
+		var property map[string]interface{}
+		if err := json.Unmarshal(data, &property); err != nil {
+			outputError("Failed to parse response", err)
+		}
+
+		data, err = c.Get(fmt.Sprintf("/properties/%s/items", args[0]))
+		if err != nil {
+			outputError("Failed to list items", err)
+		}
+
+		var items []map[string]interface{}
+		if err := json.Unmarshal(data, &items); err != nil {
+			outputError("Failed to parse response", err)
+		}
+
+		// The list includes every item; the tree starts from the top-level ones
+		roots := []map[string]interface{}{}
+		for _, item := range items {
+			if item["parentId"] == nil {
+				roots = append(roots, item)
+			}
+		}
+
+		if err := fetchItemChildren(c, roots); err != nil {
+			outputError("Failed to list children", err)
+		}
+
+		if outputFmt == "json" {
+			property["items"] = roots
+			outputJSON(property)
+		} else {
+			fmt.Fprintf(out, "%v (%v)\n", property["name"], property["id"])
+			printItemTree(roots, "")
+		}
+	},
+}
+
+// fetchItemChildren loads children for each item level by level, attaching them
+// under a "children" key. Fetches within a level run in parallel.
+func fetchItemChildren(c *client.SimpleClient, items []map[string]interface{}) error {
+	level := items
+	for len(level) > 0 {
+		results := make([][]map[string]interface{}, len(level))
+		errs := make([]error, len(level))
+		sem := make(chan struct{}, treeConcurrency)
+		var wg sync.WaitGroup
+
+		for i, item := range level {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				data, err := c.Get("/items/" + id + "/children")
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				errs[i] = json.Unmarshal(data, &results[i])
+			}(i, fmt.Sprint(item["id"]))
+		}
+		wg.Wait()
+
+		next := []map[string]interface{}{}
+		for i, item := range level {
+			if errs[i] != nil {
+				return fmt.Errorf("item %v: %w", item["id"], errs[i])
+			}
+			children := results[i]
+			if children == nil {
+				children = []map[string]interface{}{}
+			}
+			item["children"] = children
+			next = append(next, children...)
+		}
+		level = next
+	}
+	return nil
+}
+
+// printItemTree prints items as an indented tree
+func printItemTree(items []map[string]interface{}, prefix string) {
+	for i, item := range items {
+		branch, indent := "├── ", "│   "
+		if i == len(items)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(out, "%s%s%v [%v] (%v)\n", prefix, branch, item["name"], item["category"], item["id"])
+
+		children, _ := item["children"].([]map[string]interface{})
+		printItemTree(children, prefix+indent)
+	}
+}
+
 var (
 	propertyName           string
 	propertyStreetAddress  string
@@ -125,7 +244,7 @@ var propertiesCreateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(property)
+		outputRecord(property, propertyColumns)
 	},
 }
 
@@ -190,7 +309,7 @@ var propertiesUpdateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(property)
+		outputRecord(property, propertyColumns)
 	},
 }
 
@@ -206,7 +325,7 @@ var propertiesDeleteCmd = &cobra.Command{
 			outputError("Failed to delete property", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Property deleted successfully",
@@ -218,6 +337,7 @@ func init() {
 	rootCmd.AddCommand(propertiesCmd)
 	propertiesCmd.AddCommand(propertiesListCmd)
 	propertiesCmd.AddCommand(propertiesGetCmd)
+	propertiesCmd.AddCommand(propertiesTreeCmd)
 	propertiesCmd.AddCommand(propertiesCreateCmd)
 	propertiesCmd.AddCommand(propertiesUpdateCmd)
 	propertiesCmd.AddCommand(propertiesDeleteCmd)
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hausdog/cli/internal/client"
+)
+
+// treeHandler serves a property with a three-level item hierarchy
+func treeHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /properties/p1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, 200, map[string]interface{}{"id": "p1", "name": "Home"})
+	})
+	mux.HandleFunc("GET /properties/p1/items", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, 200, []map[string]interface{}{
+			{"id": "hvac", "name": "Furnace", "category": "hvac", "parentId": nil},
+			{"id": "blower", "name": "Blower", "category": "hvac", "parentId": "hvac"},
+			{"id": "motor", "name": "Motor", "category": "hvac", "parentId": "blower"},
+			{"id": "fridge", "name": "Fridge", "category": "appliance", "parentId": nil},
+		})
+	})
+	children := map[string][]map[string]interface{}{
+		"hvac":   {{"id": "blower", "name": "Blower", "category": "hvac", "parentId": "hvac"}},
+		"blower": {{"id": "motor", "name": "Motor", "category": "hvac", "parentId": "blower"}},
+	}
+	mux.HandleFunc("GET /items/{id}/children", func(w http.ResponseWriter, r *http.Request) {
+		items := children[r.PathValue("id")]
+		if items == nil {
+			items = []map[string]interface{}{}
+		}
+		writeJSON(w, 200, items)
+	})
+	return mux
+}
+
+func TestPropertiesTreeJSON(t *testing.T) {
+	buf := setupTestAPI(t, treeHandler())
+
+	propertiesTreeCmd.Run(propertiesTreeCmd, []string{"p1"})
+
+	var got struct {
+		ID    string `json:"id"`
+		Items []struct {
+			ID       string `json:"id"`
+			Children []struct {
+				ID       string `json:"id"`
+				Children []struct {
+					ID       string        `json:"id"`
+					Children []interface{} `json:"children"`
+				} `json:"children"`
+			} `json:"children"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, buf.String())
+	}
+
+	if len(got.Items) != 2 || got.Items[0].ID != "hvac" || got.Items[1].ID != "fridge" {
+		t.Fatalf("root items = %+v, want hvac and fridge", got.Items)
+	}
+	if len(got.Items[0].Children) != 1 || got.Items[0].Children[0].ID != "blower" {
+		t.Fatalf("hvac children = %+v, want blower", got.Items[0].Children)
+	}
+	motor := got.Items[0].Children[0].Children
+	if len(motor) != 1 || motor[0].ID != "motor" || motor[0].Children == nil {
+		t.Fatalf("blower children = %+v, want motor with empty children", motor)
+	}
+	if got.Items[1].Children == nil || len(got.Items[1].Children) != 0 {
+		t.Fatalf("fridge children = %+v, want empty array", got.Items[1].Children)
+	}
+}
+
+func TestPropertiesTreeTable(t *testing.T) {
+	buf := setupTestAPI(t, treeHandler())
+	outputFmt = "table"
+
+	propertiesTreeCmd.Run(propertiesTreeCmd, []string{"p1"})
+
+	want := strings.Join([]string{
+		"Home (p1)",
+		"├── Furnace [hvac] (hvac)",
+		"│   └── Blower [hvac] (blower)",
+		"│       └── Motor [hvac] (motor)",
+		"└── Fridge [appliance] (fridge)",
+		"",
+	}, "\n")
+	if buf.String() != want {
+		t.Fatalf("tree output:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestFetchItemChildrenError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /items/{id}/children", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") == "broken" {
+			writeJSON(w, 404, map[string]string{"error": "not_found", "message": "Item not found"})
+			return
+		}
+		writeJSON(w, 200, []map[string]interface{}{})
+	})
+	setupTestAPI(t, mux)
+
+	c := client.NewSimple(apiURL, apiKey)
+	items := []map[string]interface{}{{"id": "ok"}, {"id": "broken"}}
+
+	err := fetchItemChildren(c, items)
+	if err == nil {
+		t.Fatal("expected an error for the failing item")
+	}
+	if !strings.Contains(err.Error(), "item broken") {
+		t.Errorf("error %q does not name the failing item", err)
+	}
+
+	var statusErr *client.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != 404 {
+		t.Errorf("error %v does not wrap the 404 StatusError", err)
+	}
+}
@@ -2,9 +2,13 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 
+	"github.com/hausdog/cli/internal/client"
 	"github.com/hausdog/cli/internal/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -15,7 +19,20 @@ var (
 	apiKey      string
 	profileName string
 	outputFmt   string
+	outputFile  string
+	noColor     bool
 	cfg         *config.Config
+
+	// out is where command results are written (stdout or --output file)
+	out io.Writer = os.Stdout
+)
+
+// Exit codes returned by the CLI
+const (
+	exitError      = 1 // General failure
+	exitAuth       = 2 // API rejected the credentials (401/403)
+	exitNotFound   = 3 // Resource not found (404)
+	exitValidation = 4 // Request was invalid (400/422)
 )
 
 // rootCmd represents the base command
@@ -28,13 +45,26 @@ Manage properties, spaces, items, events, and documents through a simple
 command-line interface. Designed for automation and LLM agent integration.
 
 Configuration (in order of precedence):
-  1. Command-line flags (--api-url, --api-key)
-  2. Environment variables (HAUSDOG_API_URL, HAUSDOG_API_KEY)
+  1. Command-line flags (--api-url, --api-key, --property)
+  2. Environment variables (HAUSDOG_API_URL, HAUSDOG_API_KEY, HAUSDOG_PROPERTY)
   3. Config file profile (~/.config/hausdog/config.yaml)
 
+If no property is given and none is configured, commands that need one use
+your only property when the account has exactly one. Commands that add data
+(documents upload, items create, spaces create) create a property named
+"My Home" when the account has none.
+
+Exit codes:
+  0  Success
+  1  General error
+  2  Authentication failed (API returned 401 or 403)
+  3  Not found (API returned 404)
+  4  Validation failed (API returned 400 or 422)
+
 Examples:
   hausdog properties list
   hausdog --profile prod properties list
+  hausdog properties list --output properties.json
   hausdog items create --property <id> --name "HVAC System" --category hvac
   hausdog documents upload --property <id> --file ./receipt.pdf`,
 }
@@ -47,13 +77,16 @@ func Execute() {
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	cobra.OnInitialize(initConfig, initOutput)
+	cobra.OnFinalize(closeOutput)
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&apiURL, "api-url", "", "API base URL (env: HAUSDOG_API_URL)")
 	rootCmd.PersistentFlags().StringVar(&apiKey, "api-key", "", "API key (env: HAUSDOG_API_KEY)")
 	rootCmd.PersistentFlags().StringVarP(&profileName, "profile", "p", "", "Config profile to use")
 	rootCmd.PersistentFlags().StringVarP(&outputFmt, "format", "f", "json", "Output format: json, table")
+	rootCmd.PersistentFlags().StringVarP(&outputFile, "output", "o", "", "Write output to a file instead of stdout")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored table output (env: NO_COLOR)")
 
 	// Bind to viper
 	viper.BindPFlag("api_url", rootCmd.PersistentFlags().Lookup("api-url"))
@@ -74,6 +107,48 @@ func initConfig() {
 	}
 }
 
+func initOutput() {
+	if outputFile == "" {
+		return
+	}
+	out = &lazyFile{path: outputFile}
+}
+
+// closeOutput closes the --output file if one was written
+func closeOutput() {
+	if f, ok := out.(*lazyFile); ok {
+		if err := f.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error closing output file: %v\n", err)
+		}
+	}
+}
+
+// lazyFile creates its file on first write, so a command that fails before
+// producing results leaves any existing file untouched
+type lazyFile struct {
+	path string
+	f    *os.File
+}
+
+func (l *lazyFile) Write(p []byte) (int, error) {
+	if l.f == nil {
+		f, err := os.Create(l.path)
+		if err != nil {
+			return 0, fmt.Errorf("failed to open output file: %w", err)
+		}
+		l.f = f
+	}
+	return l.f.Write(p)
+}
+
+// Close closes the file if it was created
+func (l *lazyFile) Close() error {
+	if l.f == nil {
+		return nil
+	}
+	return l.f.Close()
+}
+
 // getAPIURL returns the configured API URL with proper precedence
 func getAPIURL() string {
 	// 1. Command-line flag
@@ -123,7 +198,7 @@ func getAPIKey() string {
 
 // outputJSON prints data as JSON
 func outputJSON(data interface{}) {
-	enc := json.NewEncoder(os.Stdout)
+	enc := json.NewEncoder(out)
 	enc.SetIndent("", "  ")
 	if err := enc.Encode(data); err != nil {
 		fmt.Fprintf(os.Stderr, "Error encoding output: %v\n", err)
@@ -131,17 +206,125 @@ func outputJSON(data interface{}) {
 	}
 }
 
-// outputError prints an error message and exits
+// outputError prints an error message and exits with a code derived from the error.
+// Errors never go to the --output file, so a failed run can't clobber results.
 func outputError(msg string, err error) {
 	if outputFmt == "json" {
-		outputJSON(map[string]string{
+		w := io.Writer(os.Stdout)
+		if outputFile != "" {
+			w = os.Stderr
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.Encode(map[string]string{
 			"error":   "cli_error",
 			"message": fmt.Sprintf("%s: %v", msg, err),
 		})
 	} else {
 		fmt.Fprintf(os.Stderr, "Error: %s: %v\n", msg, err)
 	}
-	os.Exit(1)
+	closeOutput()
+	os.Exit(exitCode(err))
+}
+
+// exitCode maps an error to a process exit code based on the API status
+func exitCode(err error) int {
+	var statusErr *client.StatusError
+	if !errors.As(err, &statusErr) {
+		return exitError
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return exitAuth
+	case http.StatusNotFound:
+		return exitNotFound
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return exitValidation
+	default:
+		return exitError
+	}
+}
+
+// defaultPropertyName is used when a property has to be created for an empty account
+const defaultPropertyName = "My Home"
+
+// requirePropertyID returns the property to operate on with proper precedence.
+// It never creates a property, so read-only commands can't change the account.
+func requirePropertyID(flagValue string) string {
+	return propertyID(flagValue, false)
+}
+
+// requireOrCreatePropertyID is requirePropertyID for commands that add data:
+// an account with no properties gets one created.
+func requireOrCreatePropertyID(flagValue string) string {
+	return propertyID(flagValue, true)
+}
+
+// propertyID resolves the property and reports it on stderr unless it came from the flag
+func propertyID(flagValue string, create bool) string {
+	// 1. Command-line flag
+	if flagValue != "" {
+		return flagValue
+	}
+
+	id, source := resolvePropertyID(create)
+	fmt.Fprintf(os.Stderr, "Using property %s (%s)\n", id, source)
+	return id
+}
+
+// resolvePropertyID finds the property when no flag is given, returning its ID
+// and where it came from. With create, an account with no properties gets one.
+func resolvePropertyID(create bool) (string, string) {
+	// 2. Environment variable
+	if envProperty := viper.GetString("property"); envProperty != "" {
+		return envProperty, "from HAUSDOG_PROPERTY"
+	}
+
+	// 3. Config file profile
+	if cfg != nil {
+		profile, err := cfg.GetProfile(profileName)
+		if err == nil && profile != nil && profile.DefaultProperty != "" {
+			return profile.DefaultProperty, "profile default"
+		}
+	}
+
+	// 4. The only property on the account
+	c := client.NewSimple(getAPIURL(), requireAPIKey())
+	data, err := c.Get("/properties")
+	if err != nil {
+		outputError("Failed to list properties", err)
+	}
+
+	var properties []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		outputError("Failed to parse response", err)
+	}
+
+	switch {
+	case len(properties) == 0 && create:
+		// 5. A new property for an empty account
+		data, err := c.Post("/properties", map[string]interface{}{"name": defaultPropertyName})
+		if err != nil {
+			outputError("Failed to create default property", err)
+		}
+
+		var property struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(data, &property); err != nil {
+			outputError("Failed to parse response", err)
+		}
+		return property.ID, fmt.Sprintf("created %q", defaultPropertyName)
+	case len(properties) == 1:
+		return properties[0].ID, fmt.Sprintf("only property %q", properties[0].Name)
+	default:
+		outputError("Property ID required", fmt.Errorf("use --property flag or set a default with 'config set <profile> --default-property <id>'"))
+		return "", ""
+	}
 }
 
 // requireAPIKey ensures an API key is configured
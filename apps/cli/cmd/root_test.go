@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hausdog/cli/internal/client"
+	"github.com/hausdog/cli/internal/config"
+	"github.com/spf13/viper"
+)
+
+// setupTestAPI points the CLI at a stub server and captures command output.
+// Globals changed here are restored when the test ends.
+func setupTestAPI(t *testing.T, handler http.Handler) *bytes.Buffer {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	prevURL, prevKey, prevOut, prevFmt := apiURL, apiKey, out, outputFmt
+	t.Cleanup(func() {
+		apiURL, apiKey, out, outputFmt = prevURL, prevKey, prevOut, prevFmt
+	})
+
+	var buf bytes.Buffer
+	apiURL = srv.URL
+	apiKey = "hd_testkey1234"
+	out = &buf
+	outputFmt = "json"
+	return &buf
+}
+
+// writeJSON writes v as a JSON response with the given status
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"unauthorized", client.NewStatusError(401, []byte(`{"error":"unauthorized","message":"Invalid API key"}`)), exitAuth},
+		{"forbidden", client.NewStatusError(403, []byte(`forbidden`)), exitAuth},
+		{"not found", client.NewStatusError(404, []byte(`{"error":"not_found","message":"Property not found"}`)), exitNotFound},
+		{"bad request", client.NewStatusError(400, []byte(`{"error":"bad_request","message":"No file provided"}`)), exitValidation},
+		{"unprocessable", client.NewStatusError(422, []byte(`invalid`)), exitValidation},
+		{"server error", client.NewStatusError(500, []byte(`boom`)), exitError},
+		{"wrapped", fmt.Errorf("item i1: %w", client.NewStatusError(404, nil)), exitNotFound},
+		{"non-API error", errors.New("connection refused"), exitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExitCodeFromAPIResponses(t *testing.T) {
+	setupTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/unauthorized":
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized", "message": "Invalid API key"})
+		case "/missing":
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found", "message": "Property not found"})
+		case "/invalid":
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "bad_request", "message": "Name is required"})
+		default:
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error", "message": "boom"})
+		}
+	}))
+
+	tests := []struct {
+		path        string
+		want        int
+		wantMessage string
+	}{
+		{"/unauthorized", exitAuth, "Invalid API key"},
+		{"/missing", exitNotFound, "Property not found"},
+		{"/invalid", exitValidation, "Name is required"},
+		{"/broken", exitError, "boom"},
+	}
+
+	c := client.NewSimple(getAPIURL(), getAPIKey())
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := c.Get(tt.path)
+
+			var statusErr *client.StatusError
+			if !errors.As(err, &statusErr) {
+				t.Fatalf("Get() error = %v, want *client.StatusError", err)
+			}
+			if statusErr.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", statusErr.Message, tt.wantMessage)
+			}
+			if got := exitCode(err); got != tt.want {
+				t.Errorf("exitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLazyFileOnlyTruncatesOnWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.json")
+	if err := os.WriteFile(path, []byte("previous results"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &lazyFile{path: path}
+	if err := f.Close(); err != nil {
+		t.Fatalf("Close() without writes: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "previous results" {
+		t.Fatalf("file changed without a write: %q", data)
+	}
+
+	f = &lazyFile{path: path}
+	if _, err := f.Write([]byte("new results")); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ = os.ReadFile(path)
+	if string(data) != "new results" {
+		t.Fatalf("file = %q, want %q", data, "new results")
+	}
+}
+
+// setupPropertyResolution clears every property source so each test can set one
+func setupPropertyResolution(t *testing.T, handler http.Handler) {
+	t.Helper()
+	setupTestAPI(t, handler)
+
+	viper.SetEnvPrefix("HAUSDOG")
+	viper.AutomaticEnv()
+	t.Setenv("HAUSDOG_PROPERTY", "")
+
+	prevCfg, prevProfile := cfg, profileName
+	t.Cleanup(func() { cfg, profileName = prevCfg, prevProfile })
+	cfg = &config.Config{Profiles: map[string]config.Profile{}}
+	profileName = ""
+}
+
+// failOnRequest fails the test if the API is called at all
+func failOnRequest(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		writeJSON(w, http.StatusInternalServerError, nil)
+	})
+}
+
+func TestRequirePropertyIDPrecedence(t *testing.T) {
+	t.Run("flag", func(t *testing.T) {
+		setupPropertyResolution(t, failOnRequest(t))
+		t.Setenv("HAUSDOG_PROPERTY", "env-prop")
+		cfg.Profiles["local"] = config.Profile{DefaultProperty: "profile-prop"}
+		cfg.Default = "local"
+
+		if got := requirePropertyID("flag-prop"); got != "flag-prop" {
+			t.Errorf("requirePropertyID() = %q, want flag-prop", got)
+		}
+	})
+
+	t.Run("env", func(t *testing.T) {
+		setupPropertyResolution(t, failOnRequest(t))
+		t.Setenv("HAUSDOG_PROPERTY", "env-prop")
+		cfg.Profiles["local"] = config.Profile{DefaultProperty: "profile-prop"}
+		cfg.Default = "local"
+
+		if got := requirePropertyID(""); got != "env-prop" {
+			t.Errorf("requirePropertyID() = %q, want env-prop", got)
+		}
+	})
+
+	t.Run("profile default", func(t *testing.T) {
+		setupPropertyResolution(t, failOnRequest(t))
+		cfg.Profiles["local"] = config.Profile{DefaultProperty: "profile-prop"}
+		cfg.Default = "local"
+
+		if got := requirePropertyID(""); got != "profile-prop" {
+			t.Errorf("requirePropertyID() = %q, want profile-prop", got)
+		}
+	})
+
+	t.Run("only property", func(t *testing.T) {
+		setupPropertyResolution(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != "/properties" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			writeJSON(w, http.StatusOK, []map[string]interface{}{{"id": "p1", "name": "Main House"}})
+		}))
+
+		if got := requirePropertyID(""); got != "p1" {
+			t.Errorf("requirePropertyID() = %q, want p1", got)
+		}
+	})
+}
+
+func TestRequireOrCreatePropertyIDCreatesDefault(t *testing.T) {
+	var created map[string]interface{}
+	setupPropertyResolution(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, []map[string]interface{}{})
+		case http.MethodPost:
+			if r.URL.Path != "/properties" {
+				t.Errorf("POST %s, want /properties", r.URL.Path)
+			}
+			json.NewDecoder(r.Body).Decode(&created)
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"id": "new-prop", "name": created["name"]})
+		}
+	}))
+
+	var got string
+	stderr := captureStderr(t, func() { got = requireOrCreatePropertyID("") })
+
+	if got != "new-prop" {
+		t.Errorf("requireOrCreatePropertyID() = %q, want new-prop", got)
+	}
+	if created["name"] != defaultPropertyName {
+		t.Errorf("created property name = %v, want %q", created["name"], defaultPropertyName)
+	}
+	if !strings.Contains(stderr, `created "My Home"`) {
+		t.Errorf("stderr = %q, want a notice that the property was created", stderr)
+	}
+}
+
+// TestRequirePropertyIDNeverCreates runs requirePropertyID in a subprocess,
+// since it exits through outputError when the account has no properties
+func TestRequirePropertyIDNeverCreates(t *testing.T) {
+	if url := os.Getenv("HAUSDOG_TEST_API_URL"); url != "" {
+		viper.SetEnvPrefix("HAUSDOG")
+		viper.AutomaticEnv()
+		apiURL, apiKey = url, "hd_testkey1234"
+		cfg = &config.Config{Profiles: map[string]config.Profile{}}
+		requirePropertyID("")
+		return
+	}
+
+	var posted atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posted.Store(true)
+		}
+		writeJSON(w, http.StatusOK, []map[string]interface{}{})
+	}))
+	defer srv.Close()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRequirePropertyIDNeverCreates$")
+	cmd.Env = append(os.Environ(), "HAUSDOG_TEST_API_URL="+srv.URL, "HAUSDOG_PROPERTY=")
+	output, err := cmd.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != exitError {
+		t.Fatalf("err = %v, want exit code %d\n%s", err, exitError, output)
+	}
+	if !strings.Contains(string(output), "Property ID required") {
+		t.Errorf("output = %q, want the property required error", output)
+	}
+	if posted.Load() {
+		t.Error("requirePropertyID created a property")
+	}
+}
+
+func TestRequirePropertyIDReportsOnStderr(t *testing.T) {
+	for _, format := range []string{"json", "table"} {
+		t.Run(format, func(t *testing.T) {
+			setupPropertyResolution(t, failOnRequest(t))
+			t.Setenv("HAUSDOG_PROPERTY", "env-prop")
+			outputFmt = format
+
+			stderr := captureStderr(t, func() { requirePropertyID("") })
+			if !strings.Contains(stderr, "Using property env-prop") {
+				t.Errorf("stderr = %q, want the resolved property", stderr)
+			}
+		})
+	}
+}
+
+// captureStderr returns what fn writes to os.Stderr
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevStderr := os.Stderr
+	os.Stderr = w
+	fn()
+	os.Stderr = prevStderr
+	w.Close()
+
+	data, _ := io.ReadAll(r)
+	return string(data)
+}
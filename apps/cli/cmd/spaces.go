@@ -23,9 +23,7 @@ var spacesListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List spaces for a property",
 	Run: func(cmd *cobra.Command, args []string) {
-		if spacePropertyID == "" {
-			outputError("Property ID required", fmt.Errorf("use --property flag"))
-		}
+		spacePropertyID = requirePropertyID(spacePropertyID)
 
 		c := client.NewSimple(getAPIURL(), requireAPIKey())
 
@@ -39,7 +37,7 @@ var spacesListCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(spaces)
+		outputList(spaces, spaceColumns)
 	},
 }
 
@@ -60,7 +58,7 @@ var spacesGetCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(space)
+		outputRecord(space, spaceColumns)
 	},
 }
 
@@ -68,9 +66,7 @@ var spacesCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new space",
 	Run: func(cmd *cobra.Command, args []string) {
-		if spacePropertyID == "" {
-			outputError("Property ID required", fmt.Errorf("use --property flag"))
-		}
+		spacePropertyID = requireOrCreatePropertyID(spacePropertyID)
 
 		c := client.NewSimple(getAPIURL(), requireAPIKey())
 
@@ -88,7 +84,7 @@ var spacesCreateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(space)
+		outputRecord(space, spaceColumns)
 	},
 }
 
@@ -118,7 +114,7 @@ var spacesUpdateCmd = &cobra.Command{
 			outputError("Failed to parse response", err)
 		}
 
-		outputJSON(space)
+		outputRecord(space, spaceColumns)
 	},
 }
 
@@ -134,7 +130,7 @@ var spacesDeleteCmd = &cobra.Command{
 			outputError("Failed to delete space", err)
 		}
 
-		outputJSON(map[string]string{
+		outputMessage(map[string]string{
 			"status":  "deleted",
 			"id":      args[0],
 			"message": "Space deleted successfully",
@@ -151,13 +147,11 @@ func init() {
 	spacesCmd.AddCommand(spacesDeleteCmd)
 
 	// List flags
-	spacesListCmd.Flags().StringVar(&spacePropertyID, "property", "", "Property ID (required)")
-	spacesListCmd.MarkFlagRequired("property")
+	spacesListCmd.Flags().StringVar(&spacePropertyID, "property", "", "Property ID (defaults to the configured default property)")
 
 	// Create flags
-	spacesCreateCmd.Flags().StringVar(&spacePropertyID, "property", "", "Property ID (required)")
+	spacesCreateCmd.Flags().StringVar(&spacePropertyID, "property", "", "Property ID (defaults to the configured default property)")
 	spacesCreateCmd.Flags().StringVar(&spaceName, "name", "", "Space name (required)")
-	spacesCreateCmd.MarkFlagRequired("property")
 	spacesCreateCmd.MarkFlagRequired("name")
 
 	// Update flags
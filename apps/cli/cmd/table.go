@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// ANSI color codes used for status values
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// statusColors maps document and maintenance task statuses to the color they are shown in
+var statusColors = map[string]string{
+	// Documents
+	"pending":          colorYellow,
+	"processing":       colorYellow,
+	"ready_for_review": colorYellow,
+	"confirmed":        colorGreen,
+	"discarded":        colorRed,
+
+	// Maintenance tasks
+	"active":    colorGreen,
+	"paused":    colorYellow,
+	"dismissed": colorRed,
+}
+
+// Columns shown for each resource with --format table
+var (
+	categoryColumns    = []string{"id", "slug", "name", "icon", "isSystem"}
+	documentColumns    = []string{"id", "fileName", "type", "status", "createdAt"}
+	eventColumns       = []string{"id", "type", "date", "cost", "performedBy"}
+	itemColumns        = []string{"id", "name", "category", "manufacturer", "model"}
+	maintenanceColumns = []string{"id", "name", "status", "nextDueDate"}
+	propertyColumns    = []string{"id", "name", "city", "state", "propertyType"}
+	spaceColumns       = []string{"id", "name", "createdAt"}
+)
+
+// colorEnabled reports whether colored output should be written to w.
+// Color is disabled by --no-color, by a non-empty NO_COLOR, or when w is not a terminal.
+func colorEnabled(w io.Writer) bool {
+	if noColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// writeTable writes rows as aligned columns, coloring any "status" column
+func writeTable(w io.Writer, headers []string, rows [][]string, color bool) {
+	widths := make([]int, len(headers))
+	statusCol := -1
+	for i, h := range headers {
+		widths[i] = utf8.RuneCountInString(h)
+		if strings.EqualFold(h, "status") {
+			statusCol = i
+		}
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	// Pad before coloring so escape codes don't affect alignment
+	writeRow := func(cells []string, colorize bool) {
+		parts := make([]string, len(cells))
+		for i, cell := range cells {
+			padded := cell
+			if i < len(cells)-1 {
+				// %-*s pads by bytes, so pad by runes for non-ASCII names
+				padded = cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+			}
+			if colorize && i == statusCol {
+				if c, ok := statusColors[strings.ToLower(cell)]; ok {
+					padded = c + padded + colorReset
+				}
+			}
+			parts[i] = padded
+		}
+		fmt.Fprintln(w, strings.TrimRight(strings.Join(parts, "  "), " "))
+	}
+
+	headerCells := make([]string, len(headers))
+	for i, h := range headers {
+		headerCells[i] = strings.ToUpper(h)
+	}
+	writeRow(headerCells, false)
+	for _, row := range rows {
+		writeRow(row, color)
+	}
+}
+
+// outputTable prints records as a table with the given columns.
+// Missing or null fields are shown as empty cells.
+func outputTable(records []map[string]interface{}, columns []string) {
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			if v, ok := record[col]; ok && v != nil {
+				row[i] = fmt.Sprint(v)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	writeTable(out, columns, rows, colorEnabled(out))
+}
+
+// outputList prints records as JSON, or as a table with the given columns
+func outputList(records []map[string]interface{}, columns []string) {
+	if outputFmt == "json" {
+		outputJSON(records)
+		return
+	}
+	outputTable(records, columns)
+}
+
+// outputRecord prints a single record as JSON, or as a one-row table
+func outputRecord(record map[string]interface{}, columns []string) {
+	if outputFmt == "json" {
+		outputJSON(record)
+		return
+	}
+	outputTable([]map[string]interface{}{record}, columns)
+}
+
+// outputMessage prints a command result as JSON, or just its message
+func outputMessage(result map[string]string) {
+	if outputFmt == "json" {
+		outputJSON(result)
+		return
+	}
+	fmt.Fprintln(out, result["message"])
+}
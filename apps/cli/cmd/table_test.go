@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWriteTableAlignsNonASCII(t *testing.T) {
+	var buf bytes.Buffer
+	writeTable(&buf, []string{"name", "status"}, [][]string{
+		{"Küche", "pending"},
+		{"Bad", "confirmed"},
+	}, false)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+
+	// The status column should start at the same rune offset on every line
+	want := -1
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		last := fields[len(fields)-1]
+		col := utf8.RuneCountInString(line[:strings.LastIndex(line, last)])
+		if want == -1 {
+			want = col
+		} else if col != want {
+			t.Errorf("column starts at %d, want %d:\n%s", col, want, buf.String())
+		}
+	}
+}
+
+func TestWriteTableColor(t *testing.T) {
+	rows := [][]string{{"d1", "confirmed"}, {"d2", "discarded"}}
+
+	var plain bytes.Buffer
+	writeTable(&plain, []string{"id", "status"}, rows, false)
+	if strings.Contains(plain.String(), "\033[") {
+		t.Errorf("uncolored table has escape codes: %q", plain.String())
+	}
+
+	var colored bytes.Buffer
+	writeTable(&colored, []string{"id", "status"}, rows, true)
+	if !strings.Contains(colored.String(), colorGreen+"confirmed"+colorReset) {
+		t.Errorf("confirmed not green: %q", colored.String())
+	}
+	if !strings.Contains(colored.String(), colorRed+"discarded"+colorReset) {
+		t.Errorf("discarded not red: %q", colored.String())
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	// /dev/null is a character device, so it stands in for a terminal
+	tty, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Skipf("no %s: %v", os.DevNull, err)
+	}
+	defer tty.Close()
+
+	prevNoColor := noColor
+	t.Cleanup(func() { noColor = prevNoColor })
+
+	t.Run("terminal", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		noColor = false
+		if !colorEnabled(tty) {
+			t.Error("colorEnabled() = false for a terminal")
+		}
+	})
+
+	t.Run("NO_COLOR", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "1")
+		noColor = false
+		if colorEnabled(tty) {
+			t.Error("colorEnabled() = true with NO_COLOR set")
+		}
+	})
+
+	t.Run("--no-color", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		noColor = true
+		if colorEnabled(tty) {
+			t.Error("colorEnabled() = true with --no-color")
+		}
+	})
+
+	t.Run("not a terminal", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		noColor = false
+		if colorEnabled(&bytes.Buffer{}) {
+			t.Error("colorEnabled() = true for a buffer")
+		}
+	})
+}
+
+func TestListCommandsTableFormat(t *testing.T) {
+	buf := setupTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, []map[string]interface{}{
+			{"id": "p1", "name": "Main House", "city": "Portland", "state": "OR", "propertyType": nil},
+		})
+	}))
+	outputFmt = "table"
+
+	propertiesListCmd.Run(propertiesListCmd, nil)
+
+	got := buf.String()
+	if strings.HasPrefix(strings.TrimSpace(got), "[") {
+		t.Fatalf("table format printed JSON:\n%s", got)
+	}
+	for _, want := range []string{"ID", "NAME", "CITY", "Main House", "Portland"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGetCommandsTableFormat(t *testing.T) {
+	buf := setupTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id": "s1", "name": "Garage", "createdAt": "2026-01-02T00:00:00Z",
+		})
+	}))
+	outputFmt = "table"
+
+	spacesGetCmd.Run(spacesGetCmd, []string{"s1"})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], "Garage") {
+		t.Errorf("unexpected table:\n%s", buf.String())
+	}
+}
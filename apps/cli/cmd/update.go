@@ -54,7 +54,7 @@ func init() {
 }
 
 func runUpdate() error {
-	fmt.Printf("Current version: %s\n", Version)
+	fmt.Fprintf(out, "Current version: %s\n", Version)
 
 	// Fetch latest release
 	release, err := fetchLatestRelease()
@@ -65,22 +65,22 @@ func runUpdate() error {
 	latestVersion := strings.TrimPrefix(release.TagName, "v")
 	currentVersion := strings.TrimPrefix(Version, "v")
 
-	fmt.Printf("Latest version: %s\n", latestVersion)
+	fmt.Fprintf(out, "Latest version: %s\n", latestVersion)
 
 	if !force && currentVersion == latestVersion {
-		fmt.Println("You are already on the latest version.")
+		fmt.Fprintln(out, "You are already on the latest version.")
 		return nil
 	}
 
 	if currentVersion == "dev" && !force {
-		fmt.Println("Running development version. Use --force to update.")
+		fmt.Fprintln(out, "Running development version. Use --force to update.")
 		return nil
 	}
 
 	if checkOnly {
 		if compareVersions(currentVersion, latestVersion) < 0 {
-			fmt.Printf("\nUpdate available: %s -> %s\n", currentVersion, latestVersion)
-			fmt.Println("Run 'hausdog update' to install.")
+			fmt.Fprintf(out, "\nUpdate available: %s -> %s\n", currentVersion, latestVersion)
+			fmt.Fprintln(out, "Run 'hausdog update' to install.")
 		}
 		return nil
 	}
@@ -91,7 +91,7 @@ func runUpdate() error {
 		return fmt.Errorf("no release asset found for %s/%s", runtime.GOOS, runtime.GOARCH)
 	}
 
-	fmt.Printf("\nDownloading %s...\n", asset.Name)
+	fmt.Fprintf(os.Stderr, "\nDownloading %s...\n", asset.Name)
 
 	// Download to temp file
 	tempDir, err := os.MkdirTemp("", "hausdog-update")
@@ -122,12 +122,12 @@ func runUpdate() error {
 	}
 
 	// Replace the binary
-	fmt.Printf("Installing to %s...\n", execPath)
+	fmt.Fprintf(os.Stderr, "Installing to %s...\n", execPath)
 	if err := replaceBinary(binaryPath, execPath); err != nil {
 		return fmt.Errorf("failed to install update: %w", err)
 	}
 
-	fmt.Printf("\nSuccessfully updated to version %s!\n", latestVersion)
+	fmt.Fprintf(out, "\nSuccessfully updated to version %s!\n", latestVersion)
 	return nil
 }
 
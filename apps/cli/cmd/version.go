@@ -50,11 +50,11 @@ var versionCmd = &cobra.Command{
 		if outputFmt == "json" {
 			outputJSON(info)
 		} else {
-			fmt.Printf("hausdog version %s (commit: %s)\n", Version, Commit)
+			fmt.Fprintf(out, "hausdog version %s (commit: %s)\n", Version, Commit)
 			if info["api_status"] == "healthy" {
-				fmt.Printf("API: %s (healthy)\n", apiURL)
+				fmt.Fprintf(out, "API: %s (healthy)\n", apiURL)
 			} else {
-				fmt.Printf("API: %s (%s)\n", apiURL, info["api_status"])
+				fmt.Fprintf(out, "API: %s (%s)\n", apiURL, info["api_status"])
 			}
 		}
 	},
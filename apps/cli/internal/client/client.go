@@ -33,6 +33,25 @@ type APIError struct {
 	Message string `json:"message"`
 }
 
+// StatusError is returned when the API responds with an error status code
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// NewStatusError builds a StatusError from an error response body
+func NewStatusError(statusCode int, body []byte) *StatusError {
+	var apiErr APIError
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+		return &StatusError{StatusCode: statusCode, Message: apiErr.Message}
+	}
+	return &StatusError{StatusCode: statusCode, Message: string(body)}
+}
+
 // doRequest performs an HTTP request with authentication
 func (c *SimpleClient) doRequest(method, path string, body interface{}) ([]byte, error) {
 	var reqBody io.Reader
@@ -65,11 +84,7 @@ func (c *SimpleClient) doRequest(method, path string, body interface{}) ([]byte,
 	}
 
 	if resp.StatusCode >= 400 {
-		var apiErr APIError
-		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
-			return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, apiErr.Message)
-		}
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(respBody))
+		return nil, NewStatusError(resp.StatusCode, respBody)
 	}
 
 	return respBody, nil
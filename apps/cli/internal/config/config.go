@@ -10,14 +10,18 @@ import (
 
 // Profile represents a named configuration profile
 type Profile struct {
-	APIURL string `yaml:"api_url"`
-	APIKey string `yaml:"api_key"`
+	APIURL          string `yaml:"api_url"`
+	APIKey          string `yaml:"api_key"`
+	DefaultProperty string `yaml:"default_property,omitempty"`
 }
 
 // Config represents the CLI configuration file
 type Config struct {
 	Default  string             `yaml:"default"`
 	Profiles map[string]Profile `yaml:"profiles"`
+
+	// Redacted marks an export whose API keys were masked
+	Redacted bool `yaml:"redacted,omitempty"`
 }
 
 // ConfigPath returns the path to the config file
@@ -53,6 +57,11 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
 
+	return Parse(data)
+}
+
+// Parse decodes config YAML
+func Parse(data []byte) (*Config, error) {
 	var cfg Config
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
@@ -78,9 +87,9 @@ func Save(cfg *Config) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
-	data, err := yaml.Marshal(cfg)
+	data, err := Marshal(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return err
 	}
 
 	if err := os.WriteFile(path, data, 0600); err != nil {
@@ -90,6 +99,59 @@ func Save(cfg *Config) error {
 	return nil
 }
 
+// Marshal encodes the config as YAML
+func Marshal(cfg *Config) ([]byte, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return data, nil
+}
+
+// Validate checks that the profile has the required fields
+func (p Profile) Validate() error {
+	if p.APIURL == "" {
+		return fmt.Errorf("api_url is required")
+	}
+	if p.APIKey == "" {
+		return fmt.Errorf("api_key is required")
+	}
+	return nil
+}
+
+// Validate checks every profile and that the default refers to one of them.
+// Redacted or empty configs are rejected since importing them would lose keys.
+func (c *Config) Validate() error {
+	if c.Redacted {
+		return fmt.Errorf("config is redacted; export it without --redact")
+	}
+	if len(c.Profiles) == 0 {
+		return fmt.Errorf("config has no profiles")
+	}
+	for name, p := range c.Profiles {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("profile %q: %w", name, err)
+		}
+	}
+	if c.Default != "" {
+		if _, ok := c.Profiles[c.Default]; !ok {
+			return fmt.Errorf("default profile %q not found", c.Default)
+		}
+	}
+	return nil
+}
+
+// Merge copies profiles from other, overwriting profiles with the same name.
+// The default is only taken from other if c has none.
+func (c *Config) Merge(other *Config) {
+	for name, p := range other.Profiles {
+		c.Profiles[name] = p
+	}
+	if c.Default == "" {
+		c.Default = other.Default
+	}
+}
+
 // GetProfile returns the named profile, or the default if name is empty
 func (c *Config) GetProfile(name string) (*Profile, error) {
 	if name == "" {
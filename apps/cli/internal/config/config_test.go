@@ -0,0 +1,120 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testConfig() *Config {
+	return &Config{
+		Default: "local",
+		Profiles: map[string]Profile{
+			"local": {APIURL: "http://localhost:3333/api/v1", APIKey: "hd_local_1234567890"},
+			"prod":  {APIURL: "https://hausdog.app/api/v1", APIKey: "hd_prod_1234567890", DefaultProperty: "p1"},
+		},
+	}
+}
+
+func TestMarshalParseRoundTrip(t *testing.T) {
+	cfg := testConfig()
+
+	data, err := Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "redacted") {
+		t.Errorf("unredacted export has a redacted marker:\n%s", data)
+	}
+
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, cfg) {
+		t.Errorf("round trip = %+v, want %+v", got, cfg)
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	cfg, err := Parse(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Profiles == nil {
+		t.Error("Profiles is nil, want empty map")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	cfg := testConfig()
+	cfg.Merge(&Config{
+		Default: "staging",
+		Profiles: map[string]Profile{
+			"prod":    {APIURL: "https://new.hausdog.app/api/v1", APIKey: "hd_prod_new_1234567"},
+			"staging": {APIURL: "https://staging.hausdog.app/api/v1", APIKey: "hd_staging_1234567"},
+		},
+	})
+
+	if len(cfg.Profiles) != 3 {
+		t.Errorf("got %d profiles, want 3", len(cfg.Profiles))
+	}
+	if cfg.Profiles["local"].APIKey != "hd_local_1234567890" {
+		t.Error("profile not in the import was changed")
+	}
+	if cfg.Profiles["prod"].APIURL != "https://new.hausdog.app/api/v1" {
+		t.Error("same-name profile was not overwritten")
+	}
+	if cfg.Default != "local" {
+		t.Errorf("Default = %q, want existing default kept", cfg.Default)
+	}
+}
+
+func TestMergeTakesDefaultWhenUnset(t *testing.T) {
+	cfg := &Config{Profiles: map[string]Profile{}}
+	cfg.Merge(testConfig())
+
+	if cfg.Default != "local" {
+		t.Errorf("Default = %q, want %q", cfg.Default, "local")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr string
+	}{
+		{"valid", func(c *Config) {}, ""},
+		{"missing url", func(c *Config) {
+			c.Profiles["prod"] = Profile{APIKey: "hd_prod_1234567890"}
+		}, "api_url is required"},
+		{"missing key", func(c *Config) {
+			c.Profiles["prod"] = Profile{APIURL: "https://hausdog.app/api/v1"}
+		}, "api_key is required"},
+		{"unknown default", func(c *Config) { c.Default = "staging" }, `default profile "staging" not found`},
+		{"redacted", func(c *Config) { c.Redacted = true }, "redacted"},
+		{"no profiles", func(c *Config) {
+			c.Profiles = map[string]Profile{}
+			c.Default = ""
+		}, "no profiles"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
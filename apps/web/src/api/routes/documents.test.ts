@@ -0,0 +1,144 @@
+import { OpenAPIHono } from '@hono/zod-openapi'
+import { beforeEach, describe, expect, it, vi } from 'vitest'
+import type { AuthContext } from '../middleware/auth'
+
+const mocks = vi.hoisted(() => ({
+  documentService: {
+    findById: vi.fn(),
+    create: vi.fn(),
+    updateStatus: vi.fn(),
+  },
+  propertyService: {
+    findById: vi.fn(),
+  },
+  trigger: vi.fn(),
+  storageUpload: vi.fn(),
+}))
+
+vi.mock('@/lib/db', () => ({ prisma: {} }))
+vi.mock('@/features/documents/service', () => ({
+  DocumentService: vi.fn(() => mocks.documentService),
+}))
+vi.mock('@/features/properties/service', () => ({
+  PropertyService: vi.fn(() => mocks.propertyService),
+}))
+vi.mock('@trigger.dev/sdk/v3', () => ({
+  configure: vi.fn(),
+  tasks: { trigger: mocks.trigger },
+}))
+vi.mock('@supabase/supabase-js', () => ({
+  createClient: vi.fn(() => ({
+    storage: { from: vi.fn(() => ({ upload: mocks.storageUpload })) },
+  })),
+}))
+
+const { documentsRouter } = await import('./documents')
+
+const USER_ID = '11111111-1111-4111-8111-111111111111'
+const PROPERTY_ID = '22222222-2222-4222-8222-222222222222'
+const DOCUMENT_ID = '33333333-3333-4333-8333-333333333333'
+
+function createApp() {
+  const app = new OpenAPIHono<{ Variables: AuthContext }>()
+  app.use('*', async (c, next) => {
+    c.set('userId', USER_ID)
+    await next()
+  })
+  app.route('/', documentsRouter)
+  return app
+}
+
+describe('POST /documents/{id}/reprocess', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+    mocks.documentService.findById.mockResolvedValue({ id: DOCUMENT_ID, propertyId: PROPERTY_ID })
+    mocks.documentService.updateStatus.mockResolvedValue({ id: DOCUMENT_ID, status: 'pending' })
+    mocks.propertyService.findById.mockResolvedValue({ id: PROPERTY_ID })
+    mocks.trigger.mockResolvedValue({ id: 'run_1' })
+  })
+
+  it('resets the document to pending and triggers processing', async () => {
+    const res = await createApp().request(`/documents/${DOCUMENT_ID}/reprocess`, { method: 'POST' })
+
+    expect(res.status).toBe(200)
+    expect(await res.json()).toMatchObject({ id: DOCUMENT_ID, status: 'pending' })
+    expect(mocks.documentService.updateStatus).toHaveBeenCalledWith(DOCUMENT_ID, 'pending')
+    expect(mocks.trigger).toHaveBeenCalledWith('process-document', {
+      documentId: DOCUMENT_ID,
+      userId: USER_ID,
+      propertyId: PROPERTY_ID,
+    })
+  })
+
+  it('returns 404 for a document on another user’s property', async () => {
+    mocks.propertyService.findById.mockResolvedValue(null)
+
+    const res = await createApp().request(`/documents/${DOCUMENT_ID}/reprocess`, { method: 'POST' })
+
+    expect(res.status).toBe(404)
+    expect(mocks.documentService.updateStatus).not.toHaveBeenCalled()
+    expect(mocks.trigger).not.toHaveBeenCalled()
+  })
+
+  it('returns 500 when processing cannot be triggered', async () => {
+    mocks.trigger.mockRejectedValue(new Error('Trigger.dev unavailable'))
+
+    const res = await createApp().request(`/documents/${DOCUMENT_ID}/reprocess`, { method: 'POST' })
+
+    expect(res.status).toBe(500)
+  })
+})
+
+describe('POST /properties/{propertyId}/documents/upload', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+    vi.stubEnv('SUPABASE_URL', 'http://supabase.test')
+    vi.stubEnv('SUPABASE_SERVICE_KEY', 'service-key')
+    mocks.propertyService.findById.mockResolvedValue({ id: PROPERTY_ID })
+    mocks.storageUpload.mockResolvedValue({ error: null })
+    mocks.documentService.create.mockResolvedValue({
+      id: DOCUMENT_ID,
+      status: 'pending',
+      fileName: 'receipt.pdf',
+    })
+    mocks.documentService.updateStatus.mockImplementation(async (id: string, status: string) => ({
+      id,
+      status,
+      fileName: 'receipt.pdf',
+    }))
+    mocks.trigger.mockResolvedValue({ id: 'run_1' })
+  })
+
+  function upload(extract?: string) {
+    const form = new FormData()
+    form.append('file', new File(['%PDF-1.4'], 'receipt.pdf', { type: 'application/pdf' }))
+    if (extract !== undefined) {
+      form.append('extract', extract)
+    }
+    return createApp().request(`/properties/${PROPERTY_ID}/documents/upload`, {
+      method: 'POST',
+      body: form,
+    })
+  }
+
+  it('triggers processing by default', async () => {
+    const res = await upload()
+
+    expect(res.status).toBe(201)
+    expect(await res.json()).toMatchObject({ id: DOCUMENT_ID, status: 'pending' })
+    expect(mocks.trigger).toHaveBeenCalledWith('process-document', {
+      documentId: DOCUMENT_ID,
+      userId: USER_ID,
+      propertyId: PROPERTY_ID,
+    })
+  })
+
+  it('stores the document without triggering processing when extract=false', async () => {
+    const res = await upload('false')
+
+    expect(res.status).toBe(201)
+    expect(await res.json()).toMatchObject({ id: DOCUMENT_ID, status: 'confirmed' })
+    expect(mocks.documentService.updateStatus).toHaveBeenCalledWith(DOCUMENT_ID, 'confirmed')
+    expect(mocks.trigger).not.toHaveBeenCalled()
+  })
+})
@@ -69,6 +69,12 @@ const UploadResponseSchema = z.object({
   message: z.string(),
 })
 
+const ReprocessResponseSchema = z.object({
+  id: z.string().uuid(),
+  status: z.string(),
+  message: z.string(),
+})
+
 const ErrorSchema = z.object({
   error: z.string(),
   message: z.string(),
@@ -144,7 +150,7 @@ const uploadDocument = createRoute({
   path: '/properties/{propertyId}/documents/upload',
   tags: ['Documents'],
   summary: 'Upload a document',
-  description: 'Upload a file to be processed. Supports multipart form data.',
+  description: 'Upload a file to be processed. Send extract=false to store it without extraction.',
   request: {
     params: z.object({
       propertyId: z.string().uuid(),
@@ -157,6 +163,7 @@ const uploadDocument = createRoute({
         'multipart/form-data': {
           schema: z.object({
             file: z.any().openapi({ type: 'string', format: 'binary' }),
+            extract: z.enum(['true', 'false']).optional(),
           }),
         },
       },
@@ -198,6 +205,45 @@ const uploadDocument = createRoute({
   },
 })
 
+const reprocessDocument = createRoute({
+  method: 'post',
+  path: '/documents/{id}/reprocess',
+  tags: ['Documents'],
+  summary: 'Reprocess a document',
+  description: 'Reset a document to pending and queue it for extraction again.',
+  request: {
+    params: z.object({
+      id: z.string().uuid(),
+    }),
+  },
+  responses: {
+    200: {
+      description: 'Document queued for processing',
+      content: {
+        'application/json': {
+          schema: ReprocessResponseSchema,
+        },
+      },
+    },
+    404: {
+      description: 'Document not found',
+      content: {
+        'application/json': {
+          schema: ErrorSchema,
+        },
+      },
+    },
+    500: {
+      description: 'Server error',
+      content: {
+        'application/json': {
+          schema: ErrorSchema,
+        },
+      },
+    },
+  },
+})
+
 const deleteDocument = createRoute({
   method: 'delete',
   path: '/documents/{id}',
@@ -309,6 +355,7 @@ documentsRouter.openapi(uploadDocument, async (c) => {
   // Get form data
   const formData = await c.req.formData()
   const file = formData.get('file')
+  const extract = formData.get('extract') !== 'false'
 
   if (!file || !(file instanceof File)) {
     return c.json({ error: 'bad_request', message: 'No file provided' }, 400)
@@ -380,6 +427,20 @@ documentsRouter.openapi(uploadDocument, async (c) => {
     source: 'upload',
   })
 
+  // Stored as-is: nothing to extract, so nothing to review
+  if (!extract) {
+    const stored = await documentService.updateStatus(document.id, 'confirmed')
+    return c.json(
+      {
+        id: stored.id,
+        status: stored.status,
+        fileName: stored.fileName,
+        message: 'Document stored without extraction',
+      },
+      201,
+    )
+  }
+
   // Trigger processing task
   try {
     await tasks.trigger('process-document', {
@@ -406,6 +467,47 @@ documentsRouter.openapi(uploadDocument, async (c) => {
   )
 })
 
+documentsRouter.openapi(reprocessDocument, async (c) => {
+  const userId = c.get('userId')
+  const { id } = c.req.valid('param')
+
+  const existing = await documentService.findById(id)
+  if (!existing) {
+    return c.json({ error: 'not_found', message: 'Document not found' }, 404)
+  }
+
+  // Verify ownership through property
+  const property = await propertyService.findById(existing.propertyId, userId)
+  if (!property) {
+    return c.json({ error: 'not_found', message: 'Document not found' }, 404)
+  }
+
+  const document = await documentService.updateStatus(id, 'pending')
+
+  try {
+    await tasks.trigger('process-document', {
+      documentId: id,
+      userId,
+      propertyId: existing.propertyId,
+    })
+  } catch (triggerError) {
+    logger.error('Failed to trigger document reprocessing', {
+      documentId: id,
+      error: triggerError instanceof Error ? triggerError.message : 'Unknown',
+    })
+    return c.json({ error: 'server_error', message: 'Failed to start processing' }, 500)
+  }
+
+  return c.json(
+    {
+      id: document.id,
+      status: document.status,
+      message: 'Document queued for processing',
+    },
+    200,
+  )
+})
+
 documentsRouter.openapi(deleteDocument, async (c) => {
   const userId = c.get('userId')
   const { id } = c.req.valid('param')